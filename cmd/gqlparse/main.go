@@ -0,0 +1,256 @@
+// Command gqlparse generates GraphQL queries, mutations, and subscriptions from a schema's
+// introspection result, for exploring and probing GraphQL endpoints during security testing.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/guusec/gqlparse/pkg/generator"
+	"github.com/guusec/gqlparse/pkg/introspection"
+	"github.com/guusec/gqlparse/pkg/schema"
+)
+
+// Banner to be shown in the help prompt.
+const banner = `
+█████▀███████████████████████████████████████████
+█─▄▄▄▄█─▄▄▄─█▄─▄███▄─▄▄─██▀▄─██▄─▄▄▀█─▄▄▄▄█▄─▄▄─█
+█─██▄─█─██▀─██─██▀██─▄▄▄██─▀─███─▄─▄█▄▄▄▄─██─▄█▀█
+▀▄▄▄▄▄▀───▄▄▀▄▄▄▄▄▀▄▄▄▀▀▀▄▄▀▄▄▀▄▄▀▄▄▀▄▄▄▄▄▀▄▄▄▄▄▀
+`
+
+// headerFlags collects repeatable -H "Name: value" command-line flags.
+type headerFlags []string
+
+func (h *headerFlags) String() string {
+	return strings.Join(*h, ", ")
+}
+
+func (h *headerFlags) Set(value string) error {
+	*h = append(*h, value)
+	return nil
+}
+
+// generateAndPrint builds, renders, and prints the operation for f, plus any artifacts selected
+// by -format, -batch, and -array-batch. allowBatch gates alias/array batching for mutations,
+// which require the -mutation-batch opt-in.
+func generateAndPrint(gen *generator.Generator, f introspection.Field, opType, format, targetURL string, allowBatch bool) {
+	op, vars, err := gen.Generate(f, opType)
+	if err != nil {
+		log.Fatalf("Error generating %s %s: %v", opType, f.Name, err)
+	}
+	rendered := gen.Render(op)
+	fmt.Println(rendered)
+	fmt.Println()
+	emitArtifacts(format, vars, rendered, targetURL)
+
+	if !allowBatch {
+		return
+	}
+
+	if batchOp, ok := gen.Batch(op); ok {
+		batchRendered := gen.Render(batchOp)
+		fmt.Println("batch:")
+		fmt.Println(batchRendered)
+		fmt.Println()
+		emitArtifacts(format, gen.Variables(batchOp), batchRendered, targetURL)
+	}
+
+	if arrayBatch, ok := gen.ArrayBatch(op, rendered); ok {
+		fmt.Println("array-batch:")
+		fmt.Println(arrayBatch)
+		fmt.Println()
+	}
+}
+
+// emitArtifacts prints the sample variables JSON and/or curl example for an operation, as
+// selected by format, in addition to the operation text already printed by the caller.
+func emitArtifacts(format string, vars generator.Variables, query, targetURL string) {
+	if format != "json" && format != "curl" && format != "both" {
+		return
+	}
+	if format == "json" || format == "both" {
+		varsJSON, _ := json.MarshalIndent(vars, "", "  ")
+		fmt.Println("variables:")
+		fmt.Println(string(varsJSON))
+		fmt.Println()
+	}
+	if format == "curl" || format == "both" {
+		fmt.Println("curl:")
+		fmt.Println(generator.Curl(targetURL, query, vars))
+		fmt.Println()
+	}
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "%s\n", banner)
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	// Flags for schema parsing and generation
+	schemaFile := flag.String("i", "", "JSON file with the GraphQL introspection schema")
+	includeMutations := flag.Bool("m", false, "Include mutations in generation")
+	includeSubscriptions := flag.Bool("s", false, "Include subscriptions in generation")
+	intro := flag.Bool("intro", false, "Print GraphQL introspection query in multiple formats and exit")
+	introURL := flag.String("url", "https://example.com/graphql", "GraphQL endpoint URL for -intro and per-operation curl output")
+	listDirectives := flag.Bool("list-directives", false, "List the schema's directive definitions and exit")
+	noDeprecated := flag.Bool("no-deprecated", false, "Drop deprecated fields from generated operations")
+
+	// Flags for live introspection fetching.
+	fetchURL := flag.String("fetch", "", "Fetch the introspection schema live from a GraphQL endpoint instead of -i")
+	var headers headerFlags
+	flag.Var(&headers, "H", "Extra header to send with -fetch, e.g. -H \"Authorization: Bearer xyz\" (repeatable)")
+	cookie := flag.String("cookie", "", "Cookie header to send with -fetch")
+	timeout := flag.Duration("timeout", 10*time.Second, "HTTP timeout for -fetch")
+	useGET := flag.Bool("get", false, "Send the introspection query as a URL-encoded GET instead of POST")
+	insecure := flag.Bool("insecure", false, "Skip TLS certificate verification for -fetch (self-signed certs)")
+	saveFile := flag.String("save", "", "Persist the raw -fetch response JSON to a file")
+
+	// Flags for selection-set expansion.
+	depth := flag.Int("depth", 3, "Maximum depth to recurse into nested composite fields")
+	maxRecursion := flag.Int("max-recursion", 1, "Stop recursing into a type after it has repeated this many times (cycle guard)")
+	pretty := flag.Bool("pretty", false, "Print generated operations as indented multi-line GraphQL instead of single-line")
+	format := flag.String("format", "graphql", "Artifacts to emit per operation: graphql|json|curl|both (json/curl/both also emit sample variables and/or a curl example)")
+
+	// Flags for alias-based batching.
+	batch := flag.Int("batch", 0, "Emit an additional document with N aliased copies of each field (alias-based batching)")
+	arrayBatch := flag.Int("array-batch", 0, "Emit an additional JSON array of N request bodies for each operation (array-of-requests batching)")
+	mutationBatch := flag.Bool("mutation-batch", false, "Allow -batch/-array-batch to also apply to mutations")
+	flag.Parse()
+
+	// Handle -intro flag first
+	if *intro {
+		jsonObj := map[string]string{"query": introspection.Query}
+		jsonBytes, _ := json.Marshal(jsonObj)
+		jsonStr := string(jsonBytes)
+
+		// A. JSON encoding (one-liner)
+		fmt.Println("JSON encoding:")
+		fmt.Println(jsonStr)
+		fmt.Println()
+
+		// B. URL encoding
+		data := url.Values{}
+		data.Set("query", introspection.Query)
+		fmt.Println("URL encoding:")
+		fmt.Println(data.Encode())
+		fmt.Println()
+
+		// C. curl command (body is json-encoded)
+		fmt.Println("curl example:")
+		fmt.Printf("curl -X POST %s -H \"Content-Type: application/json\" -d '%s'\n", *introURL, jsonStr)
+		return
+	}
+
+	// Either -i or -fetch must supply the introspection JSON.
+	if *schemaFile == "" && *fetchURL == "" {
+		fmt.Fprintln(os.Stderr, "Please supply an introspection file with -i or a live endpoint with -fetch.")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var data []byte
+	var err error
+	if *fetchURL != "" {
+		data, err = introspection.Fetch(*fetchURL, introspection.FetchOptions{
+			Headers:  headers,
+			Cookie:   *cookie,
+			Timeout:  *timeout,
+			UseGET:   *useGET,
+			Insecure: *insecure,
+		})
+		if err != nil {
+			log.Fatalf("Error fetching introspection schema: %v", err)
+		}
+		if *saveFile != "" {
+			if err := os.WriteFile(*saveFile, data, 0644); err != nil {
+				log.Fatalf("Error saving introspection response: %v", err)
+			}
+		}
+	} else {
+		data, err = introspection.Load(*schemaFile)
+		if err != nil {
+			log.Fatalf("Error reading file: %v", err)
+		}
+	}
+
+	rawSchema, err := introspection.Parse(data)
+	if err != nil {
+		log.Fatalf("Error parsing JSON: %v", err)
+	}
+	sch := schema.New(rawSchema)
+
+	// If -list-directives is provided, print the schema's directives and exit.
+	if *listDirectives {
+		for _, d := range sch.Directives() {
+			repeatable := ""
+			if d.IsRepeatable {
+				repeatable = " (repeatable)"
+			}
+			fmt.Printf("@%s%s on %s\n", d.Name, repeatable, strings.Join(d.Locations, " | "))
+			for _, arg := range d.Args {
+				fmt.Printf("  $%s: %s\n", arg.Name, arg.Type.String())
+			}
+		}
+		return
+	}
+
+	gen := generator.New(sch, generator.Options{
+		Depth:         *depth,
+		MaxRecursion:  *maxRecursion,
+		NoDeprecated:  *noDeprecated,
+		Pretty:        *pretty,
+		Batch:         *batch,
+		ArrayBatch:    *arrayBatch,
+		MutationBatch: *mutationBatch,
+	})
+
+	// Generate queries for each field in the Query type.
+	queryFields, err := gen.Fields("query")
+	if err != nil {
+		log.Fatalf("Error listing query fields: %v", err)
+	}
+	for _, f := range queryFields {
+		generateAndPrint(gen, f, "query", *format, *introURL, true)
+	}
+
+	// If the -m flag is provided, do the same for mutations. Batching a mutation requires the
+	// explicit -mutation-batch opt-in, since aliased/array-batched mutations are far more
+	// dangerous to fire at a live target than batched queries.
+	if *includeMutations {
+		if !sch.HasMutationType() {
+			log.Println("No mutations defined in the schema.")
+		} else {
+			mutationFields, err := gen.Fields("mutation")
+			if err != nil {
+				log.Fatalf("Error listing mutation fields: %v", err)
+			}
+			for _, f := range mutationFields {
+				generateAndPrint(gen, f, "mutation", *format, *introURL, gen.AllowsMutationBatch())
+			}
+		}
+	}
+
+	// If the -s flag is provided, do the same for subscriptions.
+	if *includeSubscriptions {
+		if !sch.HasSubscriptionType() {
+			log.Println("No subscriptions defined in the schema.")
+		} else {
+			subscriptionFields, err := gen.Fields("subscription")
+			if err != nil {
+				log.Fatalf("Error listing subscription fields: %v", err)
+			}
+			for _, f := range subscriptionFields {
+				generateAndPrint(gen, f, "subscription", *format, *introURL, true)
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Package generator builds GraphQL operations and sample variables from an indexed schema, so
+// other Go tools (fuzzers, proxies, CI checks) can drive the same generation logic gqlparse's
+// CLI uses.
+package generator
+
+import (
+	"fmt"
+
+	"github.com/guusec/gqlparse/pkg/introspection"
+	"github.com/guusec/gqlparse/pkg/schema"
+)
+
+// Operation is the root of a generated GraphQL operation's AST. Building this tree once and
+// rendering it with either RenderCompact or FormatOperation keeps both output modes in sync.
+type Operation struct {
+	Type       string
+	Name       string
+	VarDefs    []VarDef
+	Selections []Selection
+}
+
+// VarDef is a single operation variable declaration, e.g. "$status: [Status!]". It keeps the
+// underlying TypeRef and DefaultValue around so buildVariables can sample a plausible value
+// for it, not just the string rendered into the operation header.
+type VarDef struct {
+	Name         string
+	Type         introspection.TypeRef
+	DefaultValue *string
+}
+
+// String renders a VarDef as it appears in an operation's header, e.g. "$status: [Status!]".
+func (v VarDef) String() string {
+	return fmt.Sprintf("$%s: %s", v.Name, v.Type.String())
+}
+
+// Selection is a single field selection, or, when TypeCondition is set, an inline fragment
+// ("... on TypeCondition { ... }"), including its arguments and any nested selection set. Alias
+// is set when the selection is one of several aliased copies of the same field (see
+// batchOperation).
+type Selection struct {
+	Name          string
+	Alias         string
+	TypeCondition string
+	Arguments     []Argument
+	Selections    []Selection
+}
+
+// Argument is a "name: $var" argument assignment within a field call.
+type Argument struct {
+	Name string
+	Var  string
+}
+
+// buildOperation builds the AST for a GraphQL operation (query, mutation, or subscription) for
+// a given field, recursively expanding a composite return type into a real selection set (see
+// buildSelectionSet) instead of the bare "__typename" stub.
+func buildOperation(f introspection.Field, opType string, sch *schema.Schema, maxDepth, maxRecursion int, noDeprecated bool) Operation {
+	var varDefs []VarDef
+	var args []Argument
+
+	// Generate variable definitions for every top-level argument.
+	for _, arg := range f.Args {
+		varDefs = append(varDefs, VarDef{Name: arg.Name, Type: arg.Type, DefaultValue: arg.DefaultValue})
+		args = append(args, Argument{Name: arg.Name, Var: arg.Name})
+	}
+
+	// If the operation returns a composite type, expand a real selection set. This may hoist
+	// additional variable definitions for required arguments found on nested fields.
+	var children []Selection
+	if f.Type.IsComposite() {
+		seen := make(map[string]int)
+		usedVarNames := make(map[string]bool)
+		children = buildSelectionSet(f.Type, sch, 1, maxDepth, maxRecursion, seen, &varDefs, usedVarNames, noDeprecated)
+	}
+
+	return Operation{
+		Type:       opType,
+		Name:       f.Name,
+		VarDefs:    varDefs,
+		Selections: []Selection{{Name: f.Name, Arguments: args, Selections: children}},
+	}
+}
+
+// buildSelectionSet builds the selections for a composite return type, recursing into
+// OBJECT/INTERFACE/UNION subfields up to maxDepth. seen counts how many times each type name
+// has been entered on the current path; once a type has repeated more than maxRecursion times
+// (e.g. User.friends: [User]) recursion stops and falls back to a bare "__typename" to avoid
+// spinning out on self-referential schemas.
+func buildSelectionSet(t introspection.TypeRef, sch *schema.Schema, depth, maxDepth, maxRecursion int, seen map[string]int, varDefs *[]VarDef, usedVarNames map[string]bool, noDeprecated bool) []Selection {
+	inner := t.Unwrap()
+	if inner.Name == nil {
+		return []Selection{{Name: "__typename"}}
+	}
+
+	full := sch.TypeByName(*inner.Name)
+	if full == nil || depth > maxDepth || seen[*inner.Name] > maxRecursion {
+		return []Selection{{Name: "__typename"}}
+	}
+
+	seen[*inner.Name]++
+	defer func() { seen[*inner.Name]-- }()
+
+	if full.Kind == "INTERFACE" || full.Kind == "UNION" {
+		sels := []Selection{{Name: "__typename"}}
+		for _, pt := range full.PossibleTypes {
+			possible := sch.TypeByName(pt.Name)
+			if possible == nil {
+				continue
+			}
+			sels = append(sels, Selection{
+				TypeCondition: pt.Name,
+				Selections:    buildFieldSelections(possible, sch, depth, maxDepth, maxRecursion, seen, varDefs, usedVarNames, noDeprecated),
+			})
+		}
+		return sels
+	}
+
+	return buildFieldSelections(full, sch, depth, maxDepth, maxRecursion, seen, varDefs, usedVarNames, noDeprecated)
+}
+
+// buildFieldSelections builds the selections for an OBJECT/INTERFACE/UNION member type's own
+// fields, emitting scalar and enum fields directly and recursing into composite subfields.
+// Deprecated fields are dropped entirely when noDeprecated is set.
+func buildFieldSelections(full *introspection.FullType, sch *schema.Schema, depth, maxDepth, maxRecursion int, seen map[string]int, varDefs *[]VarDef, usedVarNames map[string]bool, noDeprecated bool) []Selection {
+	var sels []Selection
+	for _, field := range full.Fields {
+		if noDeprecated && field.IsDeprecated {
+			continue
+		}
+		sel := Selection{Name: field.Name, Arguments: fieldArguments(field, varDefs, usedVarNames)}
+		if field.Type.IsComposite() {
+			sel.Selections = buildSelectionSet(field.Type, sch, depth+1, maxDepth, maxRecursion, seen, varDefs, usedVarNames, noDeprecated)
+		}
+		sels = append(sels, sel)
+	}
+	if len(sels) == 0 {
+		sels = append(sels, Selection{Name: "__typename"})
+	}
+	return sels
+}
+
+// fieldArguments builds argument assignments for a nested field's required (non-null)
+// arguments, hoisting a uniquely-named "$argN_fieldname" variable for each into varDefs. The
+// same field name can be reached more than once in a single operation — through recursion (e.g.
+// User.friends: [User]) or through sibling possible types on an interface/union that share a
+// field name (e.g. Success/Failure both exposing details(id: ID!)) — so usedVarNames records
+// every final name handed out operation-wide; on a repeat of "arg0_x" the name is suffixed
+// "arg0_x_1", "arg0_x_2", ... until one is found that isn't already in usedVarNames, so a
+// suffixed name can never collide with another field's literal name (e.g. a field called "x_1").
+func fieldArguments(f introspection.Field, varDefs *[]VarDef, usedVarNames map[string]bool) []Argument {
+	var args []Argument
+	for i, arg := range f.Args {
+		if arg.Type.Kind != "NON_NULL" {
+			continue
+		}
+		base := fmt.Sprintf("arg%d_%s", i, f.Name)
+		varName := base
+		for n := 1; usedVarNames[varName]; n++ {
+			varName = fmt.Sprintf("%s_%d", base, n)
+		}
+		usedVarNames[varName] = true
+		*varDefs = append(*varDefs, VarDef{Name: varName, Type: arg.Type, DefaultValue: arg.DefaultValue})
+		args = append(args, Argument{Name: arg.Name, Var: varName})
+	}
+	return args
+}
+
+// filterDeprecated drops deprecated fields from fields when noDeprecated is set; otherwise it
+// returns fields unchanged.
+func filterDeprecated(fields []introspection.Field, noDeprecated bool) []introspection.Field {
+	if !noDeprecated {
+		return fields
+	}
+	var kept []introspection.Field
+	for _, f := range fields {
+		if !f.IsDeprecated {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
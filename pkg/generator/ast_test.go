@@ -0,0 +1,246 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/guusec/gqlparse/pkg/introspection"
+	"github.com/guusec/gqlparse/pkg/schema"
+)
+
+func nonNull(name string) introspection.TypeRef {
+	n := name
+	return introspection.TypeRef{Kind: "NON_NULL", OfType: &introspection.TypeRef{Kind: "SCALAR", Name: &n}}
+}
+
+func namedType(kind, name string) introspection.TypeRef {
+	n := name
+	return introspection.TypeRef{Kind: kind, Name: &n}
+}
+
+// selfReferentialSchema models a User type whose friends field recurses into User itself, with
+// a required argument on that recursing field — the shape that reproduces duplicate hoisted
+// variable names when the same field is reachable more than once in one operation.
+func selfReferentialSchema() *schema.Schema {
+	raw := introspection.Schema{
+		QueryType: introspection.NamedTypeRef{Name: "Query"},
+		Types: []introspection.FullType{
+			{
+				Kind: "OBJECT",
+				Name: "Query",
+				Fields: []introspection.Field{
+					{Name: "user", Type: namedType("OBJECT", "User")},
+				},
+			},
+			{
+				Kind: "OBJECT",
+				Name: "User",
+				Fields: []introspection.Field{
+					{Name: "id", Type: namedType("SCALAR", "ID")},
+					{
+						Name: "friends",
+						Type: introspection.TypeRef{Kind: "LIST", OfType: &introspection.TypeRef{Kind: "OBJECT", Name: strPtr("User")}},
+						Args: []introspection.InputValue{
+							{Name: "limit", Type: nonNull("Int")},
+						},
+					},
+				},
+			},
+		},
+	}
+	return schema.New(raw)
+}
+
+// interfaceSchema models a Result interface whose Success and Failure possible types both
+// expose a same-named details field with a required argument — the other shape that reproduces
+// duplicate hoisted variable names, this time across sibling possible types rather than depth.
+func interfaceSchema() *schema.Schema {
+	detailsField := introspection.Field{
+		Name: "details",
+		Type: namedType("SCALAR", "String"),
+		Args: []introspection.InputValue{
+			{Name: "id", Type: nonNull("ID")},
+		},
+	}
+	raw := introspection.Schema{
+		QueryType: introspection.NamedTypeRef{Name: "Query"},
+		Types: []introspection.FullType{
+			{
+				Kind: "OBJECT",
+				Name: "Query",
+				Fields: []introspection.Field{
+					{Name: "search", Type: namedType("INTERFACE", "Result")},
+				},
+			},
+			{
+				Kind: "INTERFACE",
+				Name: "Result",
+				PossibleTypes: []introspection.NamedTypeRef{
+					{Name: "Success"},
+					{Name: "Failure"},
+				},
+			},
+			{Kind: "OBJECT", Name: "Success", Fields: []introspection.Field{detailsField}},
+			{Kind: "OBJECT", Name: "Failure", Fields: []introspection.Field{detailsField}},
+		},
+	}
+	return schema.New(raw)
+}
+
+// collidingSuffixSchema models a Result interface whose Success and Failure possible types both
+// expose a field "x" with a required argument (forcing a "arg0_x" / "arg0_x_1" suffix pair), and
+// whose Other possible type separately exposes a field literally named "x_1" with a required
+// argument — so Other's unsuffixed hoist ("arg0_x_1") collides with the suffix already handed
+// out for Failure's "x".
+func collidingSuffixSchema() *schema.Schema {
+	arg := func(name string) introspection.Field {
+		return introspection.Field{
+			Name: name,
+			Type: namedType("SCALAR", "String"),
+			Args: []introspection.InputValue{{Name: "id", Type: nonNull("ID")}},
+		}
+	}
+	raw := introspection.Schema{
+		QueryType: introspection.NamedTypeRef{Name: "Query"},
+		Types: []introspection.FullType{
+			{
+				Kind: "OBJECT",
+				Name: "Query",
+				Fields: []introspection.Field{
+					{Name: "search", Type: namedType("INTERFACE", "Result")},
+				},
+			},
+			{
+				Kind: "INTERFACE",
+				Name: "Result",
+				PossibleTypes: []introspection.NamedTypeRef{
+					{Name: "Success"},
+					{Name: "Failure"},
+					{Name: "Other"},
+				},
+			},
+			{Kind: "OBJECT", Name: "Success", Fields: []introspection.Field{arg("x")}},
+			{Kind: "OBJECT", Name: "Failure", Fields: []introspection.Field{arg("x")}},
+			{Kind: "OBJECT", Name: "Other", Fields: []introspection.Field{arg("x_1")}},
+		},
+	}
+	return schema.New(raw)
+}
+
+func strPtr(s string) *string { return &s }
+
+func varNames(op Operation) []string {
+	names := make([]string, len(op.VarDefs))
+	for i, vd := range op.VarDefs {
+		names[i] = vd.Name
+	}
+	return names
+}
+
+func countVarNamesWithPrefix(op Operation, prefix string) int {
+	n := 0
+	for _, name := range varNames(op) {
+		if strings.HasPrefix(name, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+func assertUniqueVarNames(t *testing.T, op Operation) {
+	t.Helper()
+	seen := make(map[string]bool)
+	for _, name := range varNames(op) {
+		if seen[name] {
+			t.Fatalf("duplicate variable name %q in operation: %s", name, RenderCompact(op))
+		}
+		seen[name] = true
+	}
+}
+
+func TestBuildOperationVarNamesUniqueAcrossRecursion(t *testing.T) {
+	sch := selfReferentialSchema()
+	queryType, err := sch.QueryType()
+	if err != nil {
+		t.Fatalf("QueryType: %v", err)
+	}
+	queryField := queryType.Fields[0]
+
+	// Default-like settings: max-recursion 1 legitimately revisits User once, reaching the
+	// "friends" field (and its hoisted argument) at two different depths.
+	op := buildOperation(queryField, "query", sch, 3, 1, false)
+	assertUniqueVarNames(t, op)
+
+	if n := countVarNamesWithPrefix(op, "arg0_friends"); n < 2 {
+		t.Fatalf("expected the friends field's argument to be hoisted at two depths, got %d: %v", n, varNames(op))
+	}
+}
+
+func TestBuildOperationVarNamesUniqueAcrossPossibleTypes(t *testing.T) {
+	sch := interfaceSchema()
+	queryType, err := sch.QueryType()
+	if err != nil {
+		t.Fatalf("QueryType: %v", err)
+	}
+	queryField := queryType.Fields[0]
+
+	op := buildOperation(queryField, "query", sch, 3, 1, false)
+	assertUniqueVarNames(t, op)
+
+	if n := countVarNamesWithPrefix(op, "arg0_details"); n < 2 {
+		t.Fatalf("expected the details field's argument to be hoisted for both possible types, got %d: %v", n, varNames(op))
+	}
+}
+
+func TestBuildOperationVarNamesUniqueAgainstSuffixCollision(t *testing.T) {
+	sch := collidingSuffixSchema()
+	queryType, err := sch.QueryType()
+	if err != nil {
+		t.Fatalf("QueryType: %v", err)
+	}
+	queryField := queryType.Fields[0]
+
+	// Success.x and Failure.x hoist "arg0_x" then "arg0_x_1"; Other.x_1 must not also be handed
+	// the already-taken "arg0_x_1" and needs to fall through to "arg0_x_1_1" instead.
+	op := buildOperation(queryField, "query", sch, 3, 1, false)
+	assertUniqueVarNames(t, op)
+}
+
+func TestBuildSelectionSetRespectsMaxDepth(t *testing.T) {
+	sch := selfReferentialSchema()
+	queryType, err := sch.QueryType()
+	if err != nil {
+		t.Fatalf("QueryType: %v", err)
+	}
+	queryField := queryType.Fields[0]
+
+	// maxDepth 1 means only the "user" field's own selections (depth 1) are built: "friends" is
+	// selected (with its argument hoisted), but its own nested selection set, which would start
+	// at depth 2, must fall back to a bare __typename rather than expanding into User again. A
+	// generous maxRecursion isolates this to the depth guard alone.
+	op := buildOperation(queryField, "query", sch, 1, 10, false)
+	rendered := RenderCompact(op)
+
+	if n := strings.Count(rendered, "friends("); n != 1 {
+		t.Fatalf("expected exactly one \"friends(\" call at maxDepth 1, got %d: %s", n, rendered)
+	}
+}
+
+func TestBuildSelectionSetRespectsMaxRecursion(t *testing.T) {
+	sch := selfReferentialSchema()
+	queryType, err := sch.QueryType()
+	if err != nil {
+		t.Fatalf("QueryType: %v", err)
+	}
+	queryField := queryType.Fields[0]
+
+	// maxRecursion 1 lets User repeat once (friends, then friends.friends), but not twice: the
+	// third-level friends.friends.friends must fall back to a bare __typename. A generous
+	// maxDepth isolates this to the recursion-count guard alone.
+	op := buildOperation(queryField, "query", sch, 10, 1, false)
+	rendered := RenderCompact(op)
+
+	if n := strings.Count(rendered, "friends("); n != 2 {
+		t.Fatalf("expected exactly two \"friends(\" calls at maxRecursion 1, got %d: %s", n, rendered)
+	}
+}
@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// batchOperation turns op's single top-level field selection into n aliased copies
+// (a0, a1, ...) inside one operation document, for alias-based batching (rate-limit bypass,
+// brute-force via aliases). Each alias gets its own renamed "$argname_N" variables for the
+// top-level field's arguments, so a single sample variables object can drive every alias
+// independently; variables hoisted from nested required arguments are shared verbatim across
+// aliases, since they'd otherwise reference identical nested selections anyway.
+func batchOperation(op Operation, n int) Operation {
+	base := op.Selections[0]
+
+	topVarDefs := make(map[string]VarDef, len(base.Arguments))
+	for _, a := range base.Arguments {
+		for _, vd := range op.VarDefs {
+			if vd.Name == a.Var {
+				topVarDefs[a.Var] = vd
+				break
+			}
+		}
+	}
+
+	var sharedVarDefs []VarDef
+	for _, vd := range op.VarDefs {
+		if _, isTopArg := topVarDefs[vd.Name]; !isTopArg {
+			sharedVarDefs = append(sharedVarDefs, vd)
+		}
+	}
+
+	var aliasedVarDefs []VarDef
+	aliases := make([]Selection, n)
+	for i := 0; i < n; i++ {
+		var args []Argument
+		for _, a := range base.Arguments {
+			varName := fmt.Sprintf("%s_%d", a.Var, i)
+			vd := topVarDefs[a.Var]
+			aliasedVarDefs = append(aliasedVarDefs, VarDef{Name: varName, Type: vd.Type, DefaultValue: vd.DefaultValue})
+			args = append(args, Argument{Name: a.Name, Var: varName})
+		}
+		aliases[i] = Selection{Alias: fmt.Sprintf("a%d", i), Name: base.Name, Arguments: args, Selections: base.Selections}
+	}
+
+	return Operation{
+		Type:       op.Type,
+		Name:       op.Name,
+		VarDefs:    append(sharedVarDefs, aliasedVarDefs...),
+		Selections: aliases,
+	}
+}
+
+// arrayBatch renders n copies of a request (query + variables) as a JSON array, mirroring the
+// array-of-requests convention some GraphQL servers accept for batched execution over a single
+// HTTP call — as opposed to the alias-based batching in batchOperation.
+func arrayBatch(query string, variables Variables, n int) string {
+	requests := make([]Request, n)
+	for i := range requests {
+		requests[i] = Request{Query: query, Variables: variables}
+	}
+	body, _ := json.MarshalIndent(requests, "", "  ")
+	return string(body)
+}
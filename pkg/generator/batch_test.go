@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBatchOperationAliasesAndRenamesTopArgs(t *testing.T) {
+	op := Operation{
+		Type: "query",
+		Name: "search",
+		VarDefs: []VarDef{
+			{Name: "term", Type: nonNull("String")},
+			{Name: "arg0_id", Type: nonNull("ID")},
+		},
+		Selections: []Selection{
+			{
+				Name:       "search",
+				Arguments:  []Argument{{Name: "term", Var: "term"}},
+				Selections: []Selection{{Name: "id", Arguments: []Argument{{Name: "id", Var: "arg0_id"}}}},
+			},
+		},
+	}
+
+	batched := batchOperation(op, 3)
+
+	if len(batched.Selections) != 3 {
+		t.Fatalf("expected 3 aliases, got %d", len(batched.Selections))
+	}
+	for i, sel := range batched.Selections {
+		wantAlias := "a0"
+		if i == 1 {
+			wantAlias = "a1"
+		} else if i == 2 {
+			wantAlias = "a2"
+		}
+		if sel.Alias != wantAlias {
+			t.Fatalf("alias %d: expected %s, got %s", i, wantAlias, sel.Alias)
+		}
+		wantVar := "term_0"
+		if i == 1 {
+			wantVar = "term_1"
+		} else if i == 2 {
+			wantVar = "term_2"
+		}
+		if len(sel.Arguments) != 1 || sel.Arguments[0].Var != wantVar {
+			t.Fatalf("alias %d: expected top-level arg var %s, got %+v", i, wantVar, sel.Arguments)
+		}
+		// Nested selections (and their hoisted variable references) are shared verbatim.
+		if sel.Selections[0].Arguments[0].Var != "arg0_id" {
+			t.Fatalf("alias %d: expected shared nested var arg0_id, got %s", i, sel.Selections[0].Arguments[0].Var)
+		}
+	}
+
+	var sharedCount, aliasedCount int
+	for _, vd := range batched.VarDefs {
+		if vd.Name == "arg0_id" {
+			sharedCount++
+		} else {
+			aliasedCount++
+		}
+	}
+	if sharedCount != 1 {
+		t.Fatalf("expected the nested arg0_id var to appear once (shared), got %d", sharedCount)
+	}
+	if aliasedCount != 3 {
+		t.Fatalf("expected 3 aliased top-level vars, got %d", aliasedCount)
+	}
+}
+
+func TestArrayBatchProducesNCopies(t *testing.T) {
+	vars := Variables{"id": "1"}
+	got := arrayBatch(`query{ping}`, vars, 3)
+
+	var requests []Request
+	if err := json.Unmarshal([]byte(got), &requests); err != nil {
+		t.Fatalf("arrayBatch output is not valid JSON: %v", err)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(requests))
+	}
+	for i, req := range requests {
+		if req.Query != `query{ping}` {
+			t.Fatalf("request %d: expected the same query in every copy, got %s", i, req.Query)
+		}
+		if req.Variables["id"] != "1" {
+			t.Fatalf("request %d: expected the same variables in every copy, got %+v", i, req.Variables)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Request is the JSON body of a GraphQL-over-HTTP POST request.
+type Request struct {
+	Query     string    `json:"query"`
+	Variables Variables `json:"variables"`
+}
+
+// Curl renders a ready-to-run curl POST for query and variables against targetURL.
+func Curl(targetURL, query string, variables Variables) string {
+	body, _ := json.Marshal(Request{Query: query, Variables: variables})
+	return fmt.Sprintf("curl -X POST %s -H \"Content-Type: application/json\" -d '%s'", targetURL, string(body))
+}
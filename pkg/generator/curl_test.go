@@ -0,0 +1,12 @@
+package generator
+
+import "testing"
+
+func TestCurlRendersPostWithJSONBody(t *testing.T) {
+	got := Curl("https://example.com/graphql", `query{ping}`, Variables{"id": "1"})
+
+	want := `curl -X POST https://example.com/graphql -H "Content-Type: application/json" -d '{"query":"query{ping}","variables":{"id":"1"}}'`
+	if got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/guusec/gqlparse/pkg/introspection"
+	"github.com/guusec/gqlparse/pkg/schema"
+)
+
+// Options bundles the knobs that apply uniformly to every operation a Generator builds.
+type Options struct {
+	Depth         int
+	MaxRecursion  int
+	NoDeprecated  bool
+	Pretty        bool
+	Batch         int
+	ArrayBatch    int
+	MutationBatch bool
+}
+
+// Generator builds GraphQL operations and sample variables from an indexed schema.
+type Generator struct {
+	schema *schema.Schema
+	opts   Options
+}
+
+// New returns a Generator for sch configured by opts.
+func New(sch *schema.Schema, opts Options) *Generator {
+	return &Generator{schema: sch, opts: opts}
+}
+
+// validOpType reports whether opType is one of "query", "mutation", "subscription".
+func validOpType(opType string) bool {
+	switch opType {
+	case "query", "mutation", "subscription":
+		return true
+	default:
+		return false
+	}
+}
+
+// Fields returns the field definitions for opType ("query", "mutation", or "subscription"),
+// filtered per the NoDeprecated option. It returns an error if opType is not one of those three,
+// or if opType's root type is declared in the schema but its name does not resolve to a type
+// (a malformed schema) — see schema.Schema.Fields.
+func (g *Generator) Fields(opType string) ([]introspection.Field, error) {
+	if !validOpType(opType) {
+		return nil, fmt.Errorf("generator: unknown operation type %q", opType)
+	}
+	fields, err := g.schema.Fields(opType)
+	if err != nil {
+		return nil, err
+	}
+	return filterDeprecated(fields, g.opts.NoDeprecated), nil
+}
+
+// Generate builds the AST and sample variables for a single operation over field f, expanding
+// its return type into a real selection set up to the configured Depth/MaxRecursion.
+func (g *Generator) Generate(f introspection.Field, opType string) (Operation, Variables, error) {
+	if !validOpType(opType) {
+		return Operation{}, nil, fmt.Errorf("generator: unknown operation type %q", opType)
+	}
+	op := buildOperation(f, opType, g.schema, g.opts.Depth, g.opts.MaxRecursion, g.opts.NoDeprecated)
+	return op, buildVariables(g.schema, op), nil
+}
+
+// Variables returns a sample value for each of op's variable definitions, keyed by variable
+// name. Generate already returns this for the operation it builds; Variables is useful for
+// derived operations such as the one returned by Batch.
+func (g *Generator) Variables(op Operation) Variables {
+	return buildVariables(g.schema, op)
+}
+
+// Render renders op as single-line or multi-line GraphQL, per the Pretty option.
+func (g *Generator) Render(op Operation) string {
+	if g.opts.Pretty {
+		return FormatOperation(op)
+	}
+	return RenderCompact(op)
+}
+
+// Batch returns an additional operation with the configured number of aliased copies of op's
+// field (alias-based batching), and whether the Batch option is enabled at all.
+func (g *Generator) Batch(op Operation) (Operation, bool) {
+	if g.opts.Batch <= 0 {
+		return Operation{}, false
+	}
+	return batchOperation(op, g.opts.Batch), true
+}
+
+// ArrayBatch returns the configured number of copies of op's request (renderedQuery plus sample
+// variables) as a JSON array (array-of-requests batching), and whether the ArrayBatch option is
+// enabled at all.
+func (g *Generator) ArrayBatch(op Operation, renderedQuery string) (string, bool) {
+	if g.opts.ArrayBatch <= 0 {
+		return "", false
+	}
+	return arrayBatch(renderedQuery, buildVariables(g.schema, op), g.opts.ArrayBatch), true
+}
+
+// AllowsMutationBatch reports whether Batch/ArrayBatch may be applied to mutation operations.
+func (g *Generator) AllowsMutationBatch() bool {
+	return g.opts.MutationBatch
+}
@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderCompact renders op as a single-line GraphQL operation, e.g.
+// "query getUser($id: ID!) { getUser(id: $id) { name } }".
+func RenderCompact(op Operation) string {
+	var header string
+	if len(op.VarDefs) > 0 {
+		header = fmt.Sprintf("%s %s(%s)", op.Type, op.Name, strings.Join(varDefStrings(op.VarDefs), ", "))
+	} else {
+		header = op.Type
+	}
+	return header + renderSelectionsCompact(op.Selections)
+}
+
+// varDefStrings renders each VarDef as it appears in an operation's header.
+func varDefStrings(varDefs []VarDef) []string {
+	strs := make([]string, len(varDefs))
+	for i, vd := range varDefs {
+		strs[i] = vd.String()
+	}
+	return strs
+}
+
+func renderSelectionsCompact(sels []Selection) string {
+	if len(sels) == 0 {
+		return ""
+	}
+	parts := make([]string, len(sels))
+	for i, s := range sels {
+		parts[i] = renderSelectionCompact(s)
+	}
+	return " { " + strings.Join(parts, " ") + " }"
+}
+
+func renderSelectionCompact(s Selection) string {
+	name := s.Name
+	if s.TypeCondition != "" {
+		name = "... on " + s.TypeCondition
+	}
+	if s.Alias != "" {
+		name = s.Alias + ": " + name
+	}
+	return name + renderArgsCompact(s.Arguments) + renderSelectionsCompact(s.Selections)
+}
+
+func renderArgsCompact(args []Argument) string {
+	if len(args) == 0 {
+		return ""
+	}
+	parts := make([]string, len(args))
+	for i, a := range args {
+		parts[i] = fmt.Sprintf("%s: $%s", a.Name, a.Var)
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// FormatOperation renders op as multi-line GraphQL with two-space indentation, one field per
+// line and inline fragments indented under their parent, matching the style produced by
+// common GraphQL printers. It operates on the same AST as RenderCompact (see buildOperation).
+func FormatOperation(op Operation) string {
+	var b strings.Builder
+	if len(op.VarDefs) > 0 {
+		b.WriteString(fmt.Sprintf("%s %s", op.Type, op.Name))
+		b.WriteString(formatParenList(varDefStrings(op.VarDefs), 0))
+	} else {
+		b.WriteString(op.Type)
+	}
+	b.WriteString(" {\n")
+	for _, s := range op.Selections {
+		writeSelection(&b, s, 1)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// writeSelection writes a single selection (or inline fragment) at the given indent level,
+// recursing into its nested selection set.
+func writeSelection(b *strings.Builder, s Selection, indent int) {
+	pad := strings.Repeat("  ", indent)
+	name := s.Name
+	if s.TypeCondition != "" {
+		name = "... on " + s.TypeCondition
+	}
+	if s.Alias != "" {
+		name = s.Alias + ": " + name
+	}
+	b.WriteString(pad)
+	b.WriteString(name)
+
+	if len(s.Arguments) > 0 {
+		items := make([]string, len(s.Arguments))
+		for i, a := range s.Arguments {
+			items[i] = fmt.Sprintf("%s: $%s", a.Name, a.Var)
+		}
+		b.WriteString(formatParenList(items, indent))
+	}
+
+	if len(s.Selections) > 0 {
+		b.WriteString(" {\n")
+		for _, child := range s.Selections {
+			writeSelection(b, child, indent+1)
+		}
+		b.WriteString(pad)
+		b.WriteString("}")
+	}
+	b.WriteString("\n")
+}
+
+// formatParenList renders a parenthesized, comma-separated list, breaking one item per line
+// when the single-line form would exceed 80 characters.
+func formatParenList(items []string, indent int) string {
+	oneLine := "(" + strings.Join(items, ", ") + ")"
+	if len(oneLine) <= 80 {
+		return oneLine
+	}
+	inner := strings.Repeat("  ", indent+1)
+	var b strings.Builder
+	b.WriteString("(\n")
+	for _, it := range items {
+		b.WriteString(inner)
+		b.WriteString(it)
+		b.WriteString("\n")
+	}
+	b.WriteString(strings.Repeat("  ", indent))
+	b.WriteString(")")
+	return b.String()
+}
@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"encoding/json"
+
+	"github.com/guusec/gqlparse/pkg/introspection"
+	"github.com/guusec/gqlparse/pkg/schema"
+)
+
+// Variables is a JSON-ready map of sample values for an operation's variable definitions, keyed
+// by variable name (see Generator.Generate).
+type Variables map[string]interface{}
+
+// buildVariables returns a sample value for each of op's variable definitions (see
+// buildOperation), keyed by variable name.
+func buildVariables(sch *schema.Schema, op Operation) Variables {
+	vars := make(Variables, len(op.VarDefs))
+	for _, vd := range op.VarDefs {
+		vars[vd.Name] = sampleValue(sch, vd.Type, vd.DefaultValue)
+	}
+	return vars
+}
+
+// sampleValue returns a plausible JSON value for a variable's type: the argument's own
+// DefaultValue when present (parsed as JSON, or the raw string if it is not valid JSON), nil for
+// a nullable type, otherwise a typed placeholder (see sampleForNonNullType).
+func sampleValue(sch *schema.Schema, t introspection.TypeRef, defaultValue *string) interface{} {
+	if defaultValue != nil {
+		var v interface{}
+		if err := json.Unmarshal([]byte(*defaultValue), &v); err == nil {
+			return v
+		}
+		return *defaultValue
+	}
+	if t.Kind != "NON_NULL" {
+		return nil
+	}
+	return sampleForNonNullType(sch, *t.OfType)
+}
+
+// sampleForNonNullType returns a typed placeholder for a required type: [] for LIST, a
+// recursively-built object for INPUT_OBJECT, "" for String/ID/ENUM, 0 for Int/Float, and false
+// for Boolean.
+func sampleForNonNullType(sch *schema.Schema, t introspection.TypeRef) interface{} {
+	switch t.Kind {
+	case "NON_NULL":
+		return sampleForNonNullType(sch, *t.OfType)
+	case "LIST":
+		return []interface{}{}
+	case "INPUT_OBJECT":
+		obj := map[string]interface{}{}
+		if t.Name == nil {
+			return obj
+		}
+		full := sch.TypeByName(*t.Name)
+		if full == nil {
+			return obj
+		}
+		for _, field := range full.InputFields {
+			obj[field.Name] = sampleValue(sch, field.Type, field.DefaultValue)
+		}
+		return obj
+	case "SCALAR":
+		if t.Name != nil {
+			switch *t.Name {
+			case "Int", "Float":
+				return 0
+			case "Boolean":
+				return false
+			}
+		}
+		return ""
+	default: // ENUM and anything else falls back to a string placeholder.
+		return ""
+	}
+}
@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guusec/gqlparse/pkg/introspection"
+	"github.com/guusec/gqlparse/pkg/schema"
+)
+
+func TestSampleValueUsesDefaultValue(t *testing.T) {
+	sch := schema.New(introspection.Schema{})
+	def := `"hello"`
+
+	got := sampleValue(sch, nonNull("String"), &def)
+	if got != "hello" {
+		t.Fatalf("expected \"hello\", got %#v", got)
+	}
+}
+
+func TestSampleValueDefaultValueFallsBackToRawStringOnUnparseableJSON(t *testing.T) {
+	sch := schema.New(introspection.Schema{})
+	def := "not-json"
+
+	got := sampleValue(sch, nonNull("String"), &def)
+	if got != "not-json" {
+		t.Fatalf("expected the raw default value string, got %#v", got)
+	}
+}
+
+func TestSampleValueOptionalWithNoDefaultIsNil(t *testing.T) {
+	sch := schema.New(introspection.Schema{})
+
+	got := sampleValue(sch, namedType("SCALAR", "String"), nil)
+	if got != nil {
+		t.Fatalf("expected nil for an optional argument with no default, got %#v", got)
+	}
+}
+
+func TestSampleForNonNullTypeScalarPlaceholders(t *testing.T) {
+	sch := schema.New(introspection.Schema{})
+
+	cases := []struct {
+		name string
+		t    introspection.TypeRef
+		want interface{}
+	}{
+		{"Int", namedType("SCALAR", "Int"), 0},
+		{"Float", namedType("SCALAR", "Float"), 0},
+		{"Boolean", namedType("SCALAR", "Boolean"), false},
+		{"String", namedType("SCALAR", "String"), ""},
+		{"ID", namedType("SCALAR", "ID"), ""},
+		{"Enum", namedType("ENUM", "Status"), ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := sampleForNonNullType(sch, c.t)
+			if got != c.want {
+				t.Fatalf("expected %#v, got %#v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestSampleForNonNullTypeList(t *testing.T) {
+	sch := schema.New(introspection.Schema{})
+	listType := introspection.TypeRef{Kind: "LIST", OfType: &introspection.TypeRef{Kind: "SCALAR", Name: strPtr("String")}}
+
+	got := sampleForNonNullType(sch, listType)
+	if !reflect.DeepEqual(got, []interface{}{}) {
+		t.Fatalf("expected an empty slice, got %#v", got)
+	}
+}
+
+func TestSampleForNonNullTypeInputObjectRecurses(t *testing.T) {
+	raw := introspection.Schema{
+		Types: []introspection.FullType{
+			{
+				Kind: "INPUT_OBJECT",
+				Name: "Filter",
+				InputFields: []introspection.InputValue{
+					{Name: "limit", Type: nonNull("Int")},
+					{Name: "label", Type: namedType("SCALAR", "String")},
+				},
+			},
+		},
+	}
+	sch := schema.New(raw)
+
+	got := sampleForNonNullType(sch, namedType("INPUT_OBJECT", "Filter"))
+	want := map[string]interface{}{"limit": 0, "label": nil}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %#v, got %#v", want, got)
+	}
+}
+
+func TestBuildVariablesKeyedByVarDefName(t *testing.T) {
+	sch := schema.New(introspection.Schema{})
+	op := Operation{
+		VarDefs: []VarDef{
+			{Name: "id", Type: nonNull("ID")},
+			{Name: "active", Type: namedType("SCALAR", "Boolean")},
+		},
+	}
+
+	got := buildVariables(sch, op)
+	want := Variables{"id": "", "active": nil}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %#v, got %#v", want, got)
+	}
+}
@@ -0,0 +1,197 @@
+// Package introspection models the GraphQL introspection query and its JSON response, and
+// provides Fetch and Load to obtain a raw response from a live endpoint or from disk.
+package introspection
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Query is the GraphQL introspection query sent by Fetch and printed by the CLI's -intro mode.
+const Query = `{__schema{queryType{name}mutationType{name}subscriptionType{name}types{...FullType}directives{name description locations args{...InputValue}isRepeatable}}}fragment FullType on __Type{kind name description fields(includeDeprecated:true){name description args{...InputValue}type{...TypeRef}isDeprecated deprecationReason}inputFields{...InputValue}interfaces{...TypeRef}enumValues(includeDeprecated:true){name description isDeprecated deprecationReason}possibleTypes{...TypeRef}}fragment InputValue on __InputValue{name description type{...TypeRef}defaultValue}fragment TypeRef on __Type{kind name ofType{kind name ofType{kind name ofType{kind name ofType{kind name ofType{kind name ofType{kind name}}}}}}}}`
+
+// Response represents the root of an introspection query response.
+type Response struct {
+	Data struct {
+		Schema Schema `json:"__schema"`
+	} `json:"data"`
+}
+
+// Schema represents the GraphQL schema.
+type Schema struct {
+	QueryType        NamedTypeRef  `json:"queryType"`
+	MutationType     *NamedTypeRef `json:"mutationType"`
+	SubscriptionType *NamedTypeRef `json:"subscriptionType"`
+	Types            []FullType    `json:"types"`
+	Directives       []Directive   `json:"directives"`
+}
+
+// Directive represents a schema directive definition (e.g. @deprecated, @include).
+type Directive struct {
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	Locations    []string     `json:"locations"`
+	Args         []InputValue `json:"args"`
+	IsRepeatable bool         `json:"isRepeatable"`
+}
+
+// NamedTypeRef represents a type reference with just a name.
+type NamedTypeRef struct {
+	Name string `json:"name"`
+}
+
+// FullType represents a type definition from the introspection result.
+type FullType struct {
+	Kind          string         `json:"kind"`
+	Name          string         `json:"name"`
+	Fields        []Field        `json:"fields"`
+	InputFields   []InputValue   `json:"inputFields"`
+	EnumValues    []EnumValue    `json:"enumValues"`
+	PossibleTypes []NamedTypeRef `json:"possibleTypes"`
+}
+
+// Field represents a field (or operation argument in mutation and query types).
+type Field struct {
+	Name              string       `json:"name"`
+	Description       string       `json:"description"`
+	Args              []InputValue `json:"args"`
+	Type              TypeRef      `json:"type"`
+	IsDeprecated      bool         `json:"isDeprecated"`
+	DeprecationReason *string      `json:"deprecationReason"`
+}
+
+// InputValue represents an argument or input field.
+type InputValue struct {
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	Type         TypeRef `json:"type"`
+	DefaultValue *string `json:"defaultValue"`
+}
+
+// EnumValue represents an enum value definition.
+type EnumValue struct {
+	Name              string  `json:"name"`
+	Description       string  `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+}
+
+// TypeRef represents a type reference that may be wrapped (e.g., NON_NULL, LIST).
+type TypeRef struct {
+	Kind   string   `json:"kind"`
+	Name   *string  `json:"name"`
+	OfType *TypeRef `json:"ofType"`
+}
+
+// String renders t as it appears in a GraphQL variable declaration, e.g. "[Status!]!".
+func (t TypeRef) String() string {
+	switch t.Kind {
+	case "NON_NULL":
+		return t.OfType.String() + "!"
+	case "LIST":
+		return "[" + t.OfType.String() + "]"
+	default:
+		if t.Name != nil {
+			return *t.Name
+		}
+		return ""
+	}
+}
+
+// Unwrap returns the innermost type, stripping NON_NULL and LIST wrappers.
+func (t TypeRef) Unwrap() TypeRef {
+	if t.Kind == "NON_NULL" || t.Kind == "LIST" {
+		return t.OfType.Unwrap()
+	}
+	return t
+}
+
+// IsComposite reports whether t is an object, interface, or union type.
+func (t TypeRef) IsComposite() bool {
+	inner := t.Unwrap()
+	return inner.Kind == "OBJECT" || inner.Kind == "INTERFACE" || inner.Kind == "UNION"
+}
+
+// FetchOptions configures Fetch's HTTP request.
+type FetchOptions struct {
+	Headers  []string
+	Cookie   string
+	Timeout  time.Duration
+	UseGET   bool
+	Insecure bool
+}
+
+// Fetch sends the introspection query to target and returns the raw response body. When
+// opts.UseGET is true the query is sent URL-encoded as a query string; otherwise it is POSTed
+// as JSON.
+func Fetch(target string, opts FetchOptions) ([]byte, error) {
+	client := &http.Client{Timeout: opts.Timeout}
+	if opts.Insecure {
+		client.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	}
+
+	var req *http.Request
+	var err error
+	if opts.UseGET {
+		u, perr := url.Parse(target)
+		if perr != nil {
+			return nil, fmt.Errorf("invalid URL %q: %w", target, perr)
+		}
+		q := u.Query()
+		q.Set("query", Query)
+		u.RawQuery = q.Encode()
+		req, err = http.NewRequest(http.MethodGet, u.String(), nil)
+	} else {
+		body, merr := json.Marshal(map[string]string{"query": Query})
+		if merr != nil {
+			return nil, merr
+		}
+		req, err = http.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range opts.Headers {
+		name, value, ok := strings.Cut(h, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid header %q, expected \"Name: value\"", h)
+		}
+		req.Header.Set(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+	if opts.Cookie != "" {
+		req.Header.Set("Cookie", opts.Cookie)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+// Load reads a raw introspection response previously saved to disk (e.g. via Fetch + -save).
+func Load(file string) ([]byte, error) {
+	return ioutil.ReadFile(file)
+}
+
+// Parse unmarshals a raw introspection response and returns its Schema.
+func Parse(data []byte) (Schema, error) {
+	var resp Response
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return Schema{}, err
+	}
+	return resp.Data.Schema, nil
+}
@@ -0,0 +1,139 @@
+package introspection
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestFetchSendsHeadersAndCookie(t *testing.T) {
+	var gotAuth, gotCookie, gotMethod string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotCookie = r.Header.Get("Cookie")
+		gotMethod = r.Method
+		w.Write([]byte(`{"data":{"__schema":{"queryType":{"name":"Query"}}}}`))
+	}))
+	defer srv.Close()
+
+	_, err := Fetch(srv.URL, FetchOptions{
+		Headers: []string{"Authorization: Bearer xyz"},
+		Cookie:  "session=1",
+		Timeout: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if gotMethod != http.MethodPost {
+		t.Fatalf("expected POST, got %s", gotMethod)
+	}
+	if gotAuth != "Bearer xyz" {
+		t.Fatalf("expected Authorization header to be forwarded, got %q", gotAuth)
+	}
+	if gotCookie != "session=1" {
+		t.Fatalf("expected Cookie header to be forwarded, got %q", gotCookie)
+	}
+}
+
+func TestFetchUseGETEncodesQueryString(t *testing.T) {
+	var gotMethod, gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotQuery = r.URL.Query().Get("query")
+		w.Write([]byte(`{"data":{"__schema":{"queryType":{"name":"Query"}}}}`))
+	}))
+	defer srv.Close()
+
+	_, err := Fetch(srv.URL, FetchOptions{UseGET: true, Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if gotMethod != http.MethodGet {
+		t.Fatalf("expected GET, got %s", gotMethod)
+	}
+	if gotQuery != Query {
+		t.Fatalf("expected the introspection query in the querystring, got %q", gotQuery)
+	}
+}
+
+func TestFetchRejectsMalformedHeader(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	_, err := Fetch(srv.URL, FetchOptions{Headers: []string{"no-colon-here"}, Timeout: 5 * time.Second})
+	if err == nil {
+		t.Fatal("expected an error for a header with no \"Name: value\" colon, got nil")
+	}
+}
+
+func TestParseExtractsSchema(t *testing.T) {
+	data := []byte(`{"data":{"__schema":{"queryType":{"name":"Query"},"types":[{"kind":"OBJECT","name":"Query"}]}}}`)
+
+	sch, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if sch.QueryType.Name != "Query" {
+		t.Fatalf("expected QueryType.Name Query, got %s", sch.QueryType.Name)
+	}
+	if len(sch.Types) != 1 || sch.Types[0].Name != "Query" {
+		t.Fatalf("expected one Query type, got %+v", sch.Types)
+	}
+}
+
+func TestTypeRefStringRendersWrappers(t *testing.T) {
+	cases := []struct {
+		name string
+		t    TypeRef
+		want string
+	}{
+		{"scalar", TypeRef{Kind: "SCALAR", Name: strPtr("String")}, "String"},
+		{"non-null", TypeRef{Kind: "NON_NULL", OfType: &TypeRef{Kind: "SCALAR", Name: strPtr("ID")}}, "ID!"},
+		{
+			"list of non-null",
+			TypeRef{Kind: "LIST", OfType: &TypeRef{Kind: "NON_NULL", OfType: &TypeRef{Kind: "SCALAR", Name: strPtr("Status")}}},
+			"[Status!]",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.t.String(); got != c.want {
+				t.Fatalf("expected %q, got %q", c.want, got)
+			}
+		})
+	}
+}
+
+func TestTypeRefUnwrapStripsWrappers(t *testing.T) {
+	inner := TypeRef{Kind: "OBJECT", Name: strPtr("User")}
+	wrapped := TypeRef{Kind: "NON_NULL", OfType: &TypeRef{Kind: "LIST", OfType: &inner}}
+
+	got := wrapped.Unwrap()
+	if got.Kind != "OBJECT" || got.Name == nil || *got.Name != "User" {
+		t.Fatalf("expected the innermost OBJECT User, got %+v", got)
+	}
+}
+
+func TestTypeRefIsComposite(t *testing.T) {
+	cases := []struct {
+		name string
+		t    TypeRef
+		want bool
+	}{
+		{"scalar", TypeRef{Kind: "SCALAR", Name: strPtr("String")}, false},
+		{"object", TypeRef{Kind: "OBJECT", Name: strPtr("User")}, true},
+		{"non-null interface", TypeRef{Kind: "NON_NULL", OfType: &TypeRef{Kind: "INTERFACE", Name: strPtr("Result")}}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.t.IsComposite(); got != c.want {
+				t.Fatalf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Package schema indexes a raw introspection.Schema by type and directive name, and exposes the
+// Query/Mutation/Subscription root types that generators iterate over.
+package schema
+
+import (
+	"fmt"
+
+	"github.com/guusec/gqlparse/pkg/introspection"
+)
+
+// Schema indexes an introspection.Schema for fast lookups by type and directive name.
+type Schema struct {
+	raw         introspection.Schema
+	byName      map[string]*introspection.FullType
+	byDirective map[string]*introspection.Directive
+}
+
+// New indexes raw for lookups by TypeByName and DirectiveByName.
+func New(raw introspection.Schema) *Schema {
+	s := &Schema{
+		raw:         raw,
+		byName:      make(map[string]*introspection.FullType, len(raw.Types)),
+		byDirective: make(map[string]*introspection.Directive, len(raw.Directives)),
+	}
+	for i := range raw.Types {
+		s.byName[raw.Types[i].Name] = &raw.Types[i]
+	}
+	for i := range raw.Directives {
+		s.byDirective[raw.Directives[i].Name] = &raw.Directives[i]
+	}
+	return s
+}
+
+// TypeByName returns the full type definition with the given name, or nil if none exists.
+// Unlike OBJECT types, UNION types carry no Fields of their own, so this matches by name alone.
+func (s *Schema) TypeByName(name string) *introspection.FullType {
+	return s.byName[name]
+}
+
+// Directives returns the schema's directive definitions (e.g. @deprecated, @include).
+func (s *Schema) Directives() []introspection.Directive {
+	return s.raw.Directives
+}
+
+// DirectiveByName returns the directive definition with the given name, or nil if none exists.
+func (s *Schema) DirectiveByName(name string) *introspection.Directive {
+	return s.byDirective[name]
+}
+
+// HasMutationType reports whether the schema declares a Mutation root type.
+func (s *Schema) HasMutationType() bool {
+	return s.raw.MutationType != nil
+}
+
+// HasSubscriptionType reports whether the schema declares a Subscription root type.
+func (s *Schema) HasSubscriptionType() bool {
+	return s.raw.SubscriptionType != nil
+}
+
+// QueryType returns the schema's Query root type. Every valid schema declares one; an error
+// means the declared name does not resolve to a type in Types (a malformed schema).
+func (s *Schema) QueryType() (*introspection.FullType, error) {
+	t := s.TypeByName(s.raw.QueryType.Name)
+	if t == nil {
+		return nil, fmt.Errorf("schema: query type %q not found in schema types", s.raw.QueryType.Name)
+	}
+	return t, nil
+}
+
+// MutationType returns the schema's Mutation root type. It returns (nil, nil) if the schema
+// declares no mutations at all (check HasMutationType first); an error means a mutation type is
+// declared but its name does not resolve to a type in Types.
+func (s *Schema) MutationType() (*introspection.FullType, error) {
+	if s.raw.MutationType == nil {
+		return nil, nil
+	}
+	t := s.TypeByName(s.raw.MutationType.Name)
+	if t == nil {
+		return nil, fmt.Errorf("schema: mutation type %q not found in schema types", s.raw.MutationType.Name)
+	}
+	return t, nil
+}
+
+// SubscriptionType returns the schema's Subscription root type. It returns (nil, nil) if the
+// schema declares no subscriptions at all (check HasSubscriptionType first); an error means a
+// subscription type is declared but its name does not resolve to a type in Types.
+func (s *Schema) SubscriptionType() (*introspection.FullType, error) {
+	if s.raw.SubscriptionType == nil {
+		return nil, nil
+	}
+	t := s.TypeByName(s.raw.SubscriptionType.Name)
+	if t == nil {
+		return nil, fmt.Errorf("schema: subscription type %q not found in schema types", s.raw.SubscriptionType.Name)
+	}
+	return t, nil
+}
+
+// Fields returns the field definitions for the given root operation type ("query", "mutation",
+// or "subscription"). It returns (nil, nil) if that root type is legitimately absent from the
+// schema (mutation/subscription only — every valid schema declares a query type); an error means
+// opType is unrecognized, or its root type is declared but does not resolve to a type in Types.
+func (s *Schema) Fields(opType string) ([]introspection.Field, error) {
+	var t *introspection.FullType
+	var err error
+	switch opType {
+	case "query":
+		t, err = s.QueryType()
+	case "mutation":
+		t, err = s.MutationType()
+	case "subscription":
+		t, err = s.SubscriptionType()
+	default:
+		return nil, fmt.Errorf("schema: unknown operation type %q", opType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, nil
+	}
+	return t.Fields, nil
+}
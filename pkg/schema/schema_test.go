@@ -0,0 +1,135 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/guusec/gqlparse/pkg/introspection"
+)
+
+func rawSchemaWithRoots(queryName string, mutationName, subscriptionName *string) introspection.Schema {
+	raw := introspection.Schema{
+		QueryType: introspection.NamedTypeRef{Name: queryName},
+		Types: []introspection.FullType{
+			{Kind: "OBJECT", Name: "Query", Fields: []introspection.Field{{Name: "ping"}}},
+		},
+	}
+	if mutationName != nil {
+		raw.MutationType = &introspection.NamedTypeRef{Name: *mutationName}
+	}
+	if subscriptionName != nil {
+		raw.SubscriptionType = &introspection.NamedTypeRef{Name: *subscriptionName}
+	}
+	return raw
+}
+
+func TestQueryTypeResolves(t *testing.T) {
+	sch := New(rawSchemaWithRoots("Query", nil, nil))
+
+	qt, err := sch.QueryType()
+	if err != nil {
+		t.Fatalf("QueryType: %v", err)
+	}
+	if qt.Name != "Query" {
+		t.Fatalf("expected Query, got %s", qt.Name)
+	}
+}
+
+func TestQueryTypeUnresolvableIsError(t *testing.T) {
+	sch := New(rawSchemaWithRoots("Missing", nil, nil))
+
+	if _, err := sch.QueryType(); err == nil {
+		t.Fatal("expected an error for a query type name that doesn't resolve, got nil")
+	}
+}
+
+func TestMutationTypeAbsentIsNotAnError(t *testing.T) {
+	sch := New(rawSchemaWithRoots("Query", nil, nil))
+
+	if sch.HasMutationType() {
+		t.Fatal("expected HasMutationType to be false when no mutation type is declared")
+	}
+	mt, err := sch.MutationType()
+	if err != nil {
+		t.Fatalf("expected no error for a legitimately absent mutation type, got %v", err)
+	}
+	if mt != nil {
+		t.Fatalf("expected a nil mutation type, got %+v", mt)
+	}
+}
+
+func TestMutationTypeUnresolvableIsError(t *testing.T) {
+	missing := "Missing"
+	sch := New(rawSchemaWithRoots("Query", &missing, nil))
+
+	if !sch.HasMutationType() {
+		t.Fatal("expected HasMutationType to be true when a mutation type name is declared")
+	}
+	if _, err := sch.MutationType(); err == nil {
+		t.Fatal("expected an error for a mutation type name that doesn't resolve, got nil")
+	}
+}
+
+func TestSubscriptionTypeAbsentIsNotAnError(t *testing.T) {
+	sch := New(rawSchemaWithRoots("Query", nil, nil))
+
+	if sch.HasSubscriptionType() {
+		t.Fatal("expected HasSubscriptionType to be false when no subscription type is declared")
+	}
+	st, err := sch.SubscriptionType()
+	if err != nil {
+		t.Fatalf("expected no error for a legitimately absent subscription type, got %v", err)
+	}
+	if st != nil {
+		t.Fatalf("expected a nil subscription type, got %+v", st)
+	}
+}
+
+func TestSubscriptionTypeUnresolvableIsError(t *testing.T) {
+	missing := "Missing"
+	sch := New(rawSchemaWithRoots("Query", nil, &missing))
+
+	if _, err := sch.SubscriptionType(); err == nil {
+		t.Fatal("expected an error for a subscription type name that doesn't resolve, got nil")
+	}
+}
+
+func TestFieldsQuery(t *testing.T) {
+	sch := New(rawSchemaWithRoots("Query", nil, nil))
+
+	fields, err := sch.Fields("query")
+	if err != nil {
+		t.Fatalf("Fields(query): %v", err)
+	}
+	if len(fields) != 1 || fields[0].Name != "ping" {
+		t.Fatalf("expected [ping], got %+v", fields)
+	}
+}
+
+func TestFieldsMutationAbsentReturnsNilNoError(t *testing.T) {
+	sch := New(rawSchemaWithRoots("Query", nil, nil))
+
+	fields, err := sch.Fields("mutation")
+	if err != nil {
+		t.Fatalf("expected no error for an absent mutation type, got %v", err)
+	}
+	if fields != nil {
+		t.Fatalf("expected nil fields for an absent mutation type, got %+v", fields)
+	}
+}
+
+func TestFieldsUnresolvableRootTypeIsError(t *testing.T) {
+	missing := "Missing"
+	sch := New(rawSchemaWithRoots("Query", &missing, nil))
+
+	if _, err := sch.Fields("mutation"); err == nil {
+		t.Fatal("expected an error when the mutation type name doesn't resolve to a type, got nil")
+	}
+}
+
+func TestFieldsUnknownOpTypeIsError(t *testing.T) {
+	sch := New(rawSchemaWithRoots("Query", nil, nil))
+
+	if _, err := sch.Fields("bogus"); err == nil {
+		t.Fatal("expected an error for an unrecognized operation type, got nil")
+	}
+}